@@ -45,11 +45,16 @@ type Conn struct {
 	address string
 	options Options
 
+	// resolved gRPC target and dial options (may differ from address/options for local transports)
+	target      string
+	dialOptions []grpc.DialOption
+
 	once     sync.Once
 	requests chan *grpc.ClientConn
 }
 
 // New named gRPC connection with address and optional (0..1) Options. Will default to 'DefaultOptions' is not specified
+// The address may also be a local transport, i.e. 'unix://' or (on windows) 'npipe://'
 // Remember to call Start!
 func New(name, address string, opts ...Options) (*Conn, error) {
 	if len(name) == 0 {
@@ -75,6 +80,13 @@ func New(name, address string, opts ...Options) (*Conn, error) {
 		c.options = opts[0]
 	}
 
+	target, localOpts, err := resolveTarget(address)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid address '%s'", address)
+	}
+	c.target = target
+	c.dialOptions = append(append([]grpc.DialOption{}, c.options.DialOptions...), localOpts...)
+
 	return c, nil
 }
 
@@ -129,7 +141,7 @@ func (c *Conn) loop(ctx context.Context) {
 		metric_grpc_conns.WithLabelValues(labels...).Inc()
 		log.Debug("dialing")
 
-		conn, err := grpc.DialContext(ctx, c.address, c.options.DialOptions...)
+		conn, err := grpc.DialContext(ctx, c.target, c.dialOptions...)
 		if err != nil {
 			log.Error("failed to dial, will retry", "err", err)
 			metric_grpc_conns_err.WithLabelValues(labels...).Inc()
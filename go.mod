@@ -3,6 +3,7 @@ module github.com/bredtape/grpc_conn
 go 1.21
 
 require (
+	github.com/Microsoft/go-winio v0.6.2
 	github.com/bredtape/retry v0.0.1
 	github.com/grpc-ecosystem/go-grpc-prometheus v1.2.0
 	github.com/pkg/errors v0.9.1
@@ -0,0 +1,53 @@
+package grpc_conn
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+)
+
+const (
+	// Windows named pipe address prefix, e.g. 'npipe:////./pipe/my_service' or 'npipe://./pipe/my_service'
+	schemeNamedPipe = "npipe://"
+
+	// target used when the connection is established by a custom dialer (which ignores the address)
+	targetLocal = "passthrough:///localhost"
+)
+
+var ErrNamedPipeUnsupported = errors.New("named pipes are only supported on windows")
+
+// resolve address to the gRPC target and any additional dial options
+// required by platform-specific local transports.
+// Unix sockets ('unix://') are supported natively by gRPC and are passed through as-is
+func resolveTarget(address string) (string, []grpc.DialOption, error) {
+	if !strings.HasPrefix(address, schemeNamedPipe) {
+		return address, nil, nil
+	}
+
+	if !namedPipeSupported {
+		return "", nil, ErrNamedPipeUnsupported
+	}
+
+	path, err := namedPipePath(address)
+	if err != nil {
+		return "", nil, err
+	}
+
+	dialer := func(ctx context.Context, _ string) (net.Conn, error) {
+		return dialNamedPipe(ctx, path)
+	}
+	return targetLocal, []grpc.DialOption{grpc.WithContextDialer(dialer)}, nil
+}
+
+// convert 'npipe://' address to a Windows pipe path, e.g. '\\.\pipe\my_service'
+func namedPipePath(address string) (string, error) {
+	p := strings.TrimPrefix(address, schemeNamedPipe)
+	p = strings.TrimLeft(strings.ReplaceAll(p, "/", `\`), `\`)
+	if p == "" {
+		return "", errors.Errorf("empty named pipe path in address '%s'", address)
+	}
+	return `\\` + p, nil
+}
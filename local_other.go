@@ -0,0 +1,14 @@
+//go:build !windows
+
+package grpc_conn
+
+import (
+	"context"
+	"net"
+)
+
+const namedPipeSupported = false
+
+func dialNamedPipe(ctx context.Context, path string) (net.Conn, error) {
+	return nil, ErrNamedPipeUnsupported
+}
@@ -0,0 +1,16 @@
+//go:build windows
+
+package grpc_conn
+
+import (
+	"context"
+	"net"
+
+	"github.com/Microsoft/go-winio"
+)
+
+const namedPipeSupported = true
+
+func dialNamedPipe(ctx context.Context, path string) (net.Conn, error) {
+	return winio.DialPipeContext(ctx, path)
+}